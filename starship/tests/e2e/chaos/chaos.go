@@ -0,0 +1,250 @@
+// Package chaos applies and heals network/process level faults against
+// validator and relayer pods for the e2e suite's chaos tests.
+package chaos
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// chaosChain is the dedicated iptables chain Partition installs its DROP
+// rules into, so Heal can flush exactly those rules without touching any
+// other firewall state on the pod.
+const chaosChain = "STARSHIP-CHAOS"
+
+// Event records a single fault being injected or healed, so a test can assert
+// on (and print) the timeline of what happened during a run.
+type Event struct {
+	Pod    string
+	Action string
+	At     time.Time
+	Err    error
+}
+
+// Controller applies faults to validator and relayer pods in a Kubernetes
+// namespace by execing `tc netem`/`iptables` for network faults and sending
+// SIGSTOP/SIGCONT to pause/resume a pod's main process.
+type Controller struct {
+	Namespace string
+	// KubectlBin is the kubectl binary to exec through, defaults to "kubectl".
+	KubectlBin string
+
+	mu       sync.Mutex
+	timeline []Event
+}
+
+// NewController returns a Controller scoped to namespace.
+func NewController(namespace string) *Controller {
+	return &Controller{
+		Namespace:  namespace,
+		KubectlBin: "kubectl",
+	}
+}
+
+// Timeline returns a copy of the faults injected/healed so far, in order.
+func (c *Controller) Timeline() []Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := make([]Event, len(c.timeline))
+	copy(out, c.timeline)
+	return out
+}
+
+func (c *Controller) record(pod, action string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.timeline = append(c.timeline, Event{Pod: pod, Action: action, At: time.Now(), Err: err})
+}
+
+// exec runs `kubectl exec <pod> -- <args...>` against pod's default
+// container and returns stderr on failure, wrapped in the returned error.
+// It works equally against validator and relayer pods since it doesn't pin a
+// container name.
+func (c *Controller) exec(pod string, args ...string) error {
+	cmdArgs := append([]string{"exec", pod, "-n", c.Namespace, "--"}, args...)
+	cmd := exec.Command(c.KubectlBin, cmdArgs...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("kubectl exec %s %v: %w: %s", pod, args, err, stderr.String())
+	}
+	return nil
+}
+
+// podIP returns peer's cluster IP, used to scope Partition's DROP rules to
+// specific peers instead of severing all of peer's traffic.
+func (c *Controller) podIP(peer string) (string, error) {
+	cmd := exec.Command(c.KubectlBin, "get", "pod", peer, "-n", c.Namespace, "-o", "jsonpath={.status.podIP}")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("getting pod ip for %s: %w: %s", peer, err, stderr.String())
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// execOutput runs `kubectl exec <pod> -- <args...>` like exec, but returns
+// the command's trimmed stdout instead of discarding it.
+func (c *Controller) execOutput(pod string, args ...string) (string, error) {
+	cmdArgs := append([]string{"exec", pod, "-n", c.Namespace, "--"}, args...)
+	cmd := exec.Command(c.KubectlBin, cmdArgs...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("kubectl exec %s %v: %w: %s", pod, args, err, stderr.String())
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// InjectLatency adds the given latency/loss to a validator's network
+// interface using tc netem.
+func (c *Controller) InjectLatency(pod, latency, loss string) error {
+	args := []string{"tc", "qdisc", "add", "dev", "eth0", "root", "netem"}
+	if latency != "" {
+		args = append(args, "delay", latency)
+	}
+	if loss != "" {
+		args = append(args, "loss", loss)
+	}
+
+	err := c.exec(pod, args...)
+	c.record(pod, "inject-latency", err)
+	return err
+}
+
+// HealLatency removes any previously injected netem qdisc from a validator.
+func (c *Controller) HealLatency(pod string) error {
+	err := c.exec(pod, "tc", "qdisc", "del", "dev", "eth0", "root", "netem")
+	c.record(pod, "heal-latency", err)
+	return err
+}
+
+// Partition drops traffic between pod and each of peers specifically, via
+// per-peer iptables DROP rules in a dedicated chain — unlike a blanket netem
+// loss, this leaves pod reachable from (and able to reach) anything not in
+// peers, so nodes on the same side of a partition can still reach quorum
+// among themselves.
+func (c *Controller) Partition(pod string, peers []string) error {
+	if err := c.ensureChaosChain(pod); err != nil {
+		c.record(pod, "partition", err)
+		return err
+	}
+
+	var firstErr error
+	for _, peer := range peers {
+		if peer == pod {
+			continue
+		}
+
+		peerIP, err := c.podIP(peer)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if err := c.exec(pod, "iptables", "-A", chaosChain, "-s", peerIP, "-j", "DROP"); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if err := c.exec(pod, "iptables", "-A", chaosChain, "-d", peerIP, "-j", "DROP"); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	c.record(pod, "partition", firstErr)
+	return firstErr
+}
+
+// ensureChaosChain creates the dedicated iptables chain used by Partition and
+// hooks it into INPUT/OUTPUT, ignoring errors from it already existing.
+func (c *Controller) ensureChaosChain(pod string) error {
+	_ = c.exec(pod, "iptables", "-N", chaosChain)
+
+	if err := c.exec(pod, "iptables", "-C", "INPUT", "-j", chaosChain); err != nil {
+		if err := c.exec(pod, "iptables", "-I", "INPUT", "-j", chaosChain); err != nil {
+			return fmt.Errorf("hooking %s into INPUT: %w", chaosChain, err)
+		}
+	}
+	if err := c.exec(pod, "iptables", "-C", "OUTPUT", "-j", chaosChain); err != nil {
+		if err := c.exec(pod, "iptables", "-I", "OUTPUT", "-j", chaosChain); err != nil {
+			return fmt.Errorf("hooking %s into OUTPUT: %w", chaosChain, err)
+		}
+	}
+	return nil
+}
+
+// HealPartition flushes the DROP rules a prior Partition installed on pod.
+func (c *Controller) HealPartition(pod string) error {
+	err := c.exec(pod, "iptables", "-F", chaosChain)
+	c.record(pod, "heal-partition", err)
+	return err
+}
+
+// Heal reverses both a prior InjectLatency and a prior Partition on pod.
+// Errors from whichever wasn't actually applied are expected and ignored.
+func (c *Controller) Heal(pod string) error {
+	_ = c.HealLatency(pod)
+	// Without the chaos chain no Partition was ever applied to pod, so there
+	// is nothing to flush.
+	if err := c.exec(pod, "iptables", "-n", "-L", chaosChain); err != nil {
+		return nil
+	}
+	return c.HealPartition(pod)
+}
+
+// PauseValidator sends SIGSTOP to the validator process, freezing consensus
+// participation without killing the pod.
+func (c *Controller) PauseValidator(pod string) error {
+	err := c.exec(pod, "pkill", "-STOP", "-f", "starshipd")
+	c.record(pod, "pause", err)
+	return err
+}
+
+// ResumeValidator sends SIGCONT to resume a previously paused validator.
+func (c *Controller) ResumeValidator(pod string) error {
+	err := c.exec(pod, "pkill", "-CONT", "-f", "starshipd")
+	c.record(pod, "resume", err)
+	return err
+}
+
+// ResumeValidatorAfter schedules ResumeValidator to run after restart elapses
+// and returns immediately.
+func (c *Controller) ResumeValidatorAfter(pod string, restart time.Duration) {
+	time.AfterFunc(restart, func() {
+		_ = c.ResumeValidator(pod)
+	})
+}
+
+// SkewClock offsets the validator's system clock by offset for duration, then
+// restores it to the wall-clock time it had just before the skew (not the
+// Unix epoch) so the pod isn't left worse off than the induced skew.
+func (c *Controller) SkewClock(pod, offset string, duration time.Duration) error {
+	original, err := c.execOutput(pod, "date", "-u", "+%s")
+	if err != nil {
+		c.record(pod, "skew-clock", err)
+		return err
+	}
+
+	err = c.exec(pod, "date", "-s", offset)
+	c.record(pod, "skew-clock", err)
+	if err != nil {
+		return err
+	}
+
+	time.AfterFunc(duration, func() {
+		err := c.exec(pod, "date", "-u", "-s", "@"+original)
+		c.record(pod, "unskew-clock", err)
+	})
+	return nil
+}
@@ -0,0 +1,257 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hyperweb-io/starship/tests/e2e/chaos"
+	"github.com/hyperweb-io/starship/tests/e2e/setup"
+)
+
+// TestChains_ChaosRecovery injects the chaos faults declared on each chain's
+// Chaos config, waits for them to heal, and asserts the chain recovers:
+// consensus keeps advancing, relayers report healthy state again, and
+// balances reconcile.
+func (s *TestSuite) TestChains_ChaosRecovery() {
+	s.T().Log("running chaos partition/heal cycle for each chain with a chaos config")
+
+	for _, chain := range s.config.Chains {
+		if chain.Chaos == nil {
+			continue
+		}
+
+		controller := chaos.NewController(chain.ID)
+
+		for _, fault := range chain.Chaos.NetworkFaults {
+			targets := fault.Validators
+			if len(targets) == 0 {
+				targets = defaultChaosTargets(chain, s.config)
+			}
+
+			for _, pod := range targets {
+				if fault.Partition {
+					err := controller.Partition(pod, targets)
+					s.Require().NoError(err, "failed to partition pod %s", pod)
+				} else {
+					err := controller.InjectLatency(pod, fault.Latency, fault.Loss)
+					s.Require().NoError(err, "failed to inject network fault on %s", pod)
+				}
+			}
+		}
+
+		for _, skew := range chain.Chaos.ClockSkews {
+			duration, err := time.ParseDuration(skew.Duration)
+			s.Require().NoError(err, "invalid clock skew duration %q", skew.Duration)
+
+			err = controller.SkewClock(skew.Validator, skew.Offset, duration)
+			s.Require().NoError(err, "failed to skew clock on %s", skew.Validator)
+		}
+
+		for _, kill := range chain.Chaos.ValidatorKills {
+			s.Require().NoError(controller.PauseValidator(kill.Validator), "failed to pause validator %s", kill.Validator)
+
+			restart, err := time.ParseDuration(kill.Restart)
+			s.Require().NoError(err, "invalid restart duration %q", kill.Restart)
+			controller.ResumeValidatorAfter(kill.Validator, restart)
+		}
+
+		// allow faults to take effect before checking recovery
+		time.Sleep(5 * time.Second)
+
+		for _, fault := range chain.Chaos.NetworkFaults {
+			targets := fault.Validators
+			if len(targets) == 0 {
+				targets = defaultChaosTargets(chain, s.config)
+			}
+			for _, pod := range targets {
+				s.Require().NoError(controller.Heal(pod), "failed to heal pod %s", pod)
+			}
+		}
+
+		s.assertChainRecovered(chain)
+
+		s.T().Logf("chaos timeline for chain %s: %+v", chain.ID, controller.Timeline())
+	}
+}
+
+// defaultValidatorPods returns the pod names for all validators on chain when
+// a fault doesn't explicitly list which pods to target.
+func defaultValidatorPods(chain *setup.Chain) []string {
+	pods := make([]string, chain.NumValidators)
+	for i := range pods {
+		pods[i] = fmt.Sprintf("%s-genesis-%d", chain.ID, i)
+	}
+	return pods
+}
+
+// defaultRelayerPods returns the pod names of every relayer connected to
+// chain, so chain-level chaos faults reach relayers as well as validators.
+func defaultRelayerPods(chain *setup.Chain, config *setup.Config) []string {
+	var pods []string
+	for _, relayer := range config.Relayers {
+		for _, chainID := range relayer.Chains {
+			if chainID == chain.ID {
+				pods = append(pods, fmt.Sprintf("%s-0", relayer.Name))
+				break
+			}
+		}
+	}
+	return pods
+}
+
+// defaultChaosTargets returns every validator pod for chain plus every
+// relayer pod connected to it, used when a fault doesn't pin an explicit
+// pod list.
+func defaultChaosTargets(chain *setup.Chain, config *setup.Config) []string {
+	return append(defaultValidatorPods(chain), defaultRelayerPods(chain, config)...)
+}
+
+// assertChainRecovered checks that chain recovered from the injected chaos:
+// consensus keeps advancing, any relayer connected to it reports healthy
+// state again, and its configured balances reconcile.
+func (s *TestSuite) assertChainRecovered(chain *setup.Chain) {
+	s.assertBlocksAdvancing(chain)
+	s.assertConnectedRelayersHealthy(chain)
+	s.assertConfiguredBalancesReconciled(chain)
+}
+
+// assertBlocksAdvancing polls the chain's /status endpoint until block height
+// advances again, proving consensus survived the injected faults.
+func (s *TestSuite) assertBlocksAdvancing(chain *setup.Chain) {
+	if chain.Ports.Rpc == 0 {
+		return
+	}
+
+	url := fmt.Sprintf("http://0.0.0.0:%d/status", chain.Ports.Rpc)
+
+	var lastHeight int64
+	s.Require().Eventually(func() bool {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return false
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil || resp.StatusCode != 200 {
+			return false
+		}
+		defer resp.Body.Close()
+
+		data := map[string]interface{}{}
+		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+			return false
+		}
+
+		result, ok := data["result"].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		syncInfo, ok := result["sync_info"].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		heightStr, ok := syncInfo["latest_block_height"].(string)
+		if !ok {
+			return false
+		}
+		height, err := strconv.ParseInt(heightStr, 10, 64)
+		if err != nil {
+			return false
+		}
+
+		if lastHeight == 0 {
+			lastHeight = height
+			return false
+		}
+		return height > lastHeight
+	}, 60*time.Second, 2*time.Second, "chain %s did not resume producing blocks after chaos", chain.ID)
+}
+
+// assertConnectedRelayersHealthy polls /state on every hermes relayer
+// connected to chain until it reports "success" again.
+func (s *TestSuite) assertConnectedRelayersHealthy(chain *setup.Chain) {
+	for _, relayer := range s.config.Relayers {
+		if relayer.Type != "hermes" || relayer.Ports.Rest == 0 {
+			continue
+		}
+
+		connected := false
+		for _, chainID := range relayer.Chains {
+			if chainID == chain.ID {
+				connected = true
+				break
+			}
+		}
+		if !connected {
+			continue
+		}
+
+		url := fmt.Sprintf("http://0.0.0.0:%d/state", relayer.Ports.Rest)
+		s.Require().Eventually(func() bool {
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			if err != nil {
+				return false
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil || resp.StatusCode != 200 {
+				return false
+			}
+			defer resp.Body.Close()
+
+			data := map[string]interface{}{}
+			if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+				return false
+			}
+			status, _ := data["status"].(string)
+			return status == "success"
+		}, 60*time.Second, 2*time.Second, "relayer %s never reported healthy state after chaos on chain %s", relayer.Name, chain.ID)
+	}
+}
+
+// assertConfiguredBalancesReconciled polls chain's bank balances until every
+// Balance declared in config still matches its expected amount, proving the
+// chain's state reconciled rather than just producing empty blocks.
+func (s *TestSuite) assertConfiguredBalancesReconciled(chain *setup.Chain) {
+	if chain.Ports.Rest == 0 {
+		return
+	}
+
+	for _, balance := range chain.Balances {
+		url := fmt.Sprintf("http://0.0.0.0:%d/cosmos/bank/v1beta1/balances/%s", chain.Ports.Rest, balance.Address)
+
+		s.Require().Eventually(func() bool {
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			if err != nil {
+				return false
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil || resp.StatusCode != 200 {
+				return false
+			}
+			defer resp.Body.Close()
+
+			data := map[string]interface{}{}
+			if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+				return false
+			}
+
+			balances, ok := data["balances"].([]interface{})
+			if !ok {
+				return false
+			}
+			for _, b := range balances {
+				bm, ok := b.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				coins := fmt.Sprintf("%s%s", bm["amount"], bm["denom"])
+				if coins == balance.Amount {
+					return true
+				}
+			}
+			return false
+		}, 60*time.Second, 2*time.Second, "balance for %s on chain %s did not reconcile to %s after chaos", balance.Address, chain.ID, balance.Amount)
+	}
+}
@@ -0,0 +1,82 @@
+// Package cometmock is a thin JSON-RPC client for CometMock, used by the e2e
+// suite to advance blocks/time deterministically instead of sleeping.
+package cometmock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Client talks to a single chain's CometMock JSON-RPC endpoint.
+type Client struct {
+	URL string
+}
+
+// NewClient returns a Client for the CometMock endpoint at url.
+func NewClient(url string) *Client {
+	return &Client{URL: url}
+}
+
+func (c *Client) call(method string, params interface{}) error {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+		"id":      1,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.URL, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling cometmock %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	var data struct {
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return fmt.Errorf("decoding cometmock %s response: %w", method, err)
+	}
+	if data.Error != nil {
+		return fmt.Errorf("cometmock %s: %s", method, data.Error.Message)
+	}
+	return nil
+}
+
+// AdvanceBlocks produces n blocks immediately.
+func (c *Client) AdvanceBlocks(n int) error {
+	return c.call("advance_blocks", map[string]interface{}{"num_blocks": n})
+}
+
+// AdvanceTime moves the chain's block time forward by d, producing a single
+// block at the new time.
+func (c *Client) AdvanceTime(d time.Duration) error {
+	return c.call("advance_time", map[string]interface{}{"duration_seconds": int64(d.Seconds())})
+}
+
+// SetSigningStatus toggles whether validator (by address) signs the next
+// blocks, used to simulate a halted validator without pausing its process.
+func (c *Client) SetSigningStatus(validatorAddr string, up bool) error {
+	status := "down"
+	if up {
+		status = "up"
+	}
+	return c.call("set_signing_status", map[string]interface{}{
+		"private_key_address": validatorAddr,
+		"status":              status,
+	})
+}
@@ -0,0 +1,164 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/hyperweb-io/starship/tests/e2e/cometmock"
+	"github.com/hyperweb-io/starship/tests/e2e/setup"
+)
+
+// maxUnbondingTestFee bounds how much of the delegator's "stake" balance the
+// delegate/undelegate tx pair in TestStaking_UnbondingByTimeTravel is allowed
+// to have consumed in gas fees, so the test doesn't assume txs are free.
+var maxUnbondingTestFee = big.NewInt(5000)
+
+// cometmockClient returns a cometmock.Client for chain, skipping the calling
+// test if the chain doesn't have CometMock enabled.
+func (s *TestSuite) cometmockClient(chain *setup.Chain) *cometmock.Client {
+	if chain.Cometmock == nil || !chain.Cometmock.Enabled || chain.Cometmock.Ports.Rpc == 0 {
+		s.T().Skip("chain does not have cometmock enabled")
+	}
+	return cometmock.NewClient(fmt.Sprintf("http://0.0.0.0:%d", chain.Cometmock.Ports.Rpc))
+}
+
+// AdvanceBlocks produces n blocks on chainID via CometMock.
+func (s *TestSuite) AdvanceBlocks(chainID string, n int) {
+	chain := s.config.GetChain(chainID)
+	s.Require().NotNil(chain, "chain %s not found", chainID)
+	s.Require().NoError(s.cometmockClient(chain).AdvanceBlocks(n))
+}
+
+// AdvanceTime moves chainID's block time forward by d via CometMock.
+func (s *TestSuite) AdvanceTime(chainID string, d time.Duration) {
+	chain := s.config.GetChain(chainID)
+	s.Require().NotNil(chain, "chain %s not found", chainID)
+	s.Require().NoError(s.cometmockClient(chain).AdvanceTime(d))
+}
+
+// HaltValidator marks valAddr as down so CometMock stops signing with it.
+func (s *TestSuite) HaltValidator(chainID, valAddr string) {
+	chain := s.config.GetChain(chainID)
+	s.Require().NotNil(chain, "chain %s not found", chainID)
+	s.Require().NoError(s.cometmockClient(chain).SetSigningStatus(valAddr, false))
+}
+
+// execValidator runs a starshipd CLI command on the chain's first validator
+// pod, eg for submitting txs that the e2e suite has no signing client for.
+func execValidator(chainID string, args ...string) (string, error) {
+	cmdArgs := append([]string{"exec", fmt.Sprintf("%s-genesis-0", chainID), "-n", chainID, "-c", "validator", "--"}, args...)
+	cmd := exec.Command("kubectl", cmdArgs...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("kubectl exec %v: %w: %s", args, err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// TestGov_ParamChangeByTimeTravel submits a param-change proposal, advances
+// past the chain's voting_period using CometMock instead of sleeping, and
+// asserts the param actually changed.
+func (s *TestSuite) TestGov_ParamChangeByTimeTravel() {
+	chain := s.config.Chains[0]
+	if chain.Cometmock == nil || !chain.Cometmock.Enabled {
+		s.T().Skip("first configured chain does not have cometmock enabled")
+	}
+
+	_, err := execValidator(chain.ID,
+		"starshipd", "tx", "gov", "submit-proposal", "param-change", "/configs/param-change-proposal.json",
+		"--from", "validator", "--chain-id", chain.ID, "--keyring-backend", "test", "-y")
+	s.Require().NoError(err, "submitting param-change proposal")
+
+	_, err = execValidator(chain.ID, "starshipd", "tx", "gov", "vote", "1", "yes",
+		"--from", "validator", "--chain-id", chain.ID, "--keyring-backend", "test", "-y")
+	s.Require().NoError(err, "voting on proposal")
+
+	s.AdvanceTime(chain.ID, 300*time.Second)
+	s.AdvanceBlocks(chain.ID, 1)
+
+	url := fmt.Sprintf("http://0.0.0.0:%d/cosmos/staking/v1beta1/params", chain.Ports.Rest)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	s.Require().NoError(err)
+	body := s.MakeRequest(req, 200)
+
+	data := map[string]interface{}{}
+	s.Require().NoError(json.NewDecoder(body).Decode(&data))
+	s.Require().Equal("10s", data["params"].(map[string]interface{})["unbonding_time"], "param change did not take effect after voting_period")
+}
+
+// TestStaking_UnbondingByTimeTravel delegates, undelegates, advances past
+// unbonding_time via CometMock, and asserts the delegator's "stake" balance
+// is restored to its pre-delegation amount, less at most maxUnbondingTestFee
+// in tx fees, without waiting for the real unbonding period.
+func (s *TestSuite) TestStaking_UnbondingByTimeTravel() {
+	chain := s.config.Chains[0]
+	if chain.Cometmock == nil || !chain.Cometmock.Enabled {
+		s.T().Skip("first configured chain does not have cometmock enabled")
+	}
+	if len(chain.Balances) == 0 {
+		s.T().Skip("chain has no funded accounts configured to delegate from")
+	}
+
+	delegator := chain.Balances[0].Address
+	beforeBalance := s.bankBalance(chain, delegator, "stake")
+
+	_, err := execValidator(chain.ID, "starshipd", "tx", "staking", "delegate",
+		fmt.Sprintf("%svaloper1", chain.ID), "1000stake",
+		"--from", delegator, "--chain-id", chain.ID, "--keyring-backend", "test", "-y")
+	s.Require().NoError(err, "delegating")
+
+	_, err = execValidator(chain.ID, "starshipd", "tx", "staking", "unbond",
+		fmt.Sprintf("%svaloper1", chain.ID), "1000stake",
+		"--from", delegator, "--chain-id", chain.ID, "--keyring-backend", "test", "-y")
+	s.Require().NoError(err, "undelegating")
+
+	s.AdvanceTime(chain.ID, 300*time.Second)
+	s.AdvanceBlocks(chain.ID, 1)
+
+	afterBalance := s.bankBalance(chain, delegator, "stake")
+
+	before, ok := new(big.Int).SetString(beforeBalance, 10)
+	s.Require().True(ok, "parsing pre-delegation balance %q", beforeBalance)
+	after, ok := new(big.Int).SetString(afterBalance, 10)
+	s.Require().True(ok, "parsing post-unbond balance %q", afterBalance)
+
+	// The delegate/undelegate txs each pay a gas fee, so the restored balance
+	// won't be byte-exact with the pre-delegation balance; just bound how
+	// much of it the fees are allowed to have consumed.
+	fee := new(big.Int).Sub(before, after)
+	s.Require().True(fee.Sign() >= 0, "delegator stake balance increased after unbonding: before %s, after %s", beforeBalance, afterBalance)
+	s.Require().True(fee.Cmp(maxUnbondingTestFee) <= 0, "delegator stake balance dropped by more than the expected tx fees (max %s): before %s, after %s", maxUnbondingTestFee, beforeBalance, afterBalance)
+}
+
+// bankBalance returns the amount of denom address holds on chain, or "0" if
+// it holds none.
+func (s *TestSuite) bankBalance(chain *setup.Chain, address, denom string) string {
+	url := fmt.Sprintf("http://0.0.0.0:%d/cosmos/bank/v1beta1/balances/%s", chain.Ports.Rest, address)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	s.Require().NoError(err)
+	body := s.MakeRequest(req, 200)
+
+	data := map[string]interface{}{}
+	s.Require().NoError(json.NewDecoder(body).Decode(&data))
+	balances, ok := data["balances"].([]interface{})
+	s.Require().True(ok, "balances should be an array")
+
+	for _, b := range balances {
+		bm, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if bm["denom"] == denom {
+			amount, _ := bm["amount"].(string)
+			return amount
+		}
+	}
+	return "0"
+}
@@ -2,30 +2,39 @@ package e2e
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/stretchr/testify/suite"
-	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 
 	pb "github.com/hyperweb-io/starship/exposer/exposer"
+	"github.com/hyperweb-io/starship/tests/e2e/setup"
 )
 
 var configEnvKey = "TEST_CONFIG_FILE"
 
+// orchestrateEnvKey opts the suite into standing the environment up itself
+// via setup.SetupChains instead of assuming CI already installed the chart
+// and port-forwarded it, for running the suite against a throwaway cluster.
+var orchestrateEnvKey = "STARSHIP_ORCHESTRATE"
+
 type TestSuite struct {
 	suite.Suite
 
-	configFile string
-	config     *Config
+	configFile   string
+	config       *setup.Config
+	orchestrator *setup.Orchestrator
 }
 
 func TestE2ETestSuite(t *testing.T) {
@@ -43,9 +52,17 @@ func (s *TestSuite) SetupTest() {
 	}
 	configFile = strings.Replace(configFile, "starship/", "", -1)
 	configFile = strings.Replace(configFile, "tests/e2e/", "", -1)
+
+	if os.Getenv(orchestrateEnvKey) == "true" {
+		s.orchestrator = setup.SetupChains(s.T(), configFile)
+		s.config = s.orchestrator.Config
+		s.configFile = configFile
+		return
+	}
+
 	yamlFile, err := os.ReadFile(configFile)
 	s.Require().NoError(err)
-	config := &Config{}
+	config := &setup.Config{}
 	err = yaml.Unmarshal(yamlFile, config)
 	s.Require().NoError(err)
 
@@ -53,13 +70,10 @@ func (s *TestSuite) SetupTest() {
 	s.configFile = configFile
 }
 
+// MakeRequest is a thin wrapper around setup.MakeRequest so existing call
+// sites on the suite don't need to change.
 func (s *TestSuite) MakeRequest(req *http.Request, expCode int) io.Reader {
-	resp, err := http.DefaultClient.Do(req)
-	s.Require().NoError(err, "trying to make request", zap.Any("request", req))
-
-	s.Require().Equal(expCode, resp.StatusCode, "response code did not match")
-
-	return resp.Body
+	return setup.MakeRequest(s.T(), req, expCode)
 }
 
 func (s *TestSuite) TestChains_Status() {
@@ -217,11 +231,11 @@ func (s *TestSuite) TestChainsEth_Balances() {
 		}
 
 		if chain.Balances == nil {
-			chain.Balances = []Balance{}
+			chain.Balances = []setup.Balance{}
 		}
 
 		// add default balance to chain balances
-		chain.Balances = append(chain.Balances, Balance{
+		chain.Balances = append(chain.Balances, setup.Balance{
 			Address: "0x0000000000000000000000000000000000000001",
 			Amount:  "0x3635c9adc5dea00000"})
 
@@ -256,3 +270,49 @@ func (s *TestSuite) TestChainsEth_Balances() {
 		}
 	}
 }
+
+func (s *TestSuite) TestChainsEth_Contracts() {
+	s.T().Log("running test for contract deployment and calls on the ethereum chain")
+
+	for _, chain := range s.config.Chains {
+		if chain.Name != "ethereum" {
+			continue
+		}
+		if len(chain.EthContracts) == 0 {
+			s.T().Skip("no eth contracts configured")
+		}
+
+		faucet := chain.Faucet
+		if faucet == nil {
+			faucet = s.config.Faucet
+		}
+		s.Require().NotNil(faucet, "ethereum chain requires a faucet mnemonic to deploy contracts")
+
+		chainID, err := strconv.ParseInt(chain.ID, 10, 64)
+		s.Require().NoError(err, "parsing eth chain id %q", chain.ID)
+
+		url := fmt.Sprintf("http://0.0.0.0:%d", chain.Ports.Rest)
+		signer, err := setup.NewEthSignerFromMnemonic(faucet.Mnemonic, big.NewInt(chainID))
+		s.Require().NoError(err, "deriving signer from faucet mnemonic")
+
+		for _, contract := range chain.EthContracts {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			addr, err := setup.DeployContract(ctx, url, signer, contract)
+			cancel()
+			s.Require().NoError(err, "deploying contract %s", contract.Name)
+			s.T().Logf("deployed contract %s at %s", contract.Name, addr.Hex())
+
+			parsedABI, _, err := setup.LoadArtifact(contract)
+			s.Require().NoError(err, "loading ABI for %s", contract.Name)
+
+			for _, call := range contract.Calls {
+				out, err := setup.CallContract(url, addr, parsedABI, call)
+				s.Require().NoError(err, "calling %s.%s", contract.Name, call.Method)
+				s.Require().NotEmpty(out, "%s.%s returned no values", contract.Name, call.Method)
+
+				got := fmt.Sprintf("%v", out[0])
+				s.Require().Equal(call.Expected, got, "%s.%s return mismatch", contract.Name, call.Method)
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hyperweb-io/starship/tests/e2e/setup"
+)
+
+// FaucetClient requests funds from a chain's faucet, mirroring the faucet's
+// `noauth` mode so CI tests can fund arbitrary addresses without any
+// out-of-band auth step.
+type FaucetClient struct {
+	URL string
+}
+
+// NewFaucetClient returns a FaucetClient talking to the faucet at url.
+func NewFaucetClient(url string) *FaucetClient {
+	return &FaucetClient{URL: url}
+}
+
+// faucetClientFor resolves the faucet feature for chainID (falling back to
+// the top-level faucet) and returns a client for it, or an error if the chain
+// or its faucet aren't configured.
+func faucetClientFor(config *setup.Config, chainID string) (*FaucetClient, error) {
+	chain := config.GetChain(chainID)
+	if chain == nil {
+		return nil, fmt.Errorf("chain %s not found in config", chainID)
+	}
+
+	faucet := chain.Faucet
+	if faucet == nil {
+		faucet = config.Faucet
+	}
+	if faucet == nil || !faucet.Enabled {
+		return nil, fmt.Errorf("chain %s has no faucet enabled", chainID)
+	}
+	if !faucet.NoAuth {
+		return nil, fmt.Errorf("faucet for chain %s requires auth, FaucetClient only supports noauth mode", chainID)
+	}
+
+	port := faucet.Ports.Faucet
+	if port == 0 {
+		port = chain.Ports.Faucet
+	}
+	return NewFaucetClient(fmt.Sprintf("http://0.0.0.0:%d", port)), nil
+}
+
+// Fund requests coins be sent to address from the faucet.
+func (f *FaucetClient) Fund(ctx context.Context, address, coins string) error {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"address": address,
+		"denom":   coins,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.URL+"/credit", bytes.NewBuffer(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting funds for %s: %w", address, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("faucet returned status %d for %s", resp.StatusCode, address)
+	}
+	return nil
+}
+
+// FundMany requests coins for each balance in turn, stopping at the first
+// error.
+func (f *FaucetClient) FundMany(ctx context.Context, balances []setup.Balance) error {
+	for _, balance := range balances {
+		if err := f.Fund(ctx, balance.Address, balance.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
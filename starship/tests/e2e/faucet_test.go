@@ -0,0 +1,134 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/hyperweb-io/starship/tests/e2e/setup"
+)
+
+// TestFaucet_Fund requests funds for a throwaway address from each chain's
+// noauth faucet and polls until the coins land, both for Cosmos chains (via
+// the bank balance endpoint) and for the ethereum chain (via eth_getBalance).
+func (s *TestSuite) TestFaucet_Fund() {
+	s.T().Log("running test for faucet noauth funding")
+
+	for _, chain := range s.config.Chains {
+		faucet, err := faucetClientFor(s.config, chain.ID)
+		if err != nil {
+			s.T().Logf("skipping faucet test for chain %s: %v", chain.ID, err)
+			continue
+		}
+
+		amount := "100"
+		denom := "token"
+		address := "star1faucettestaddressxxxxxxxxxxxxxxxxxxxx"
+		if chain.Name == "ethereum" {
+			amount = "1000000000000000000"
+			denom = ""
+			address = "0x0000000000000000000000000000000000000002"
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		err = faucet.Fund(ctx, address, amount+denom)
+		cancel()
+		s.Require().NoError(err, "funding %s on chain %s", address, chain.ID)
+
+		if chain.Name == "ethereum" {
+			s.assertEthBalanceFunded(chain, address, amount)
+		} else {
+			s.assertBankBalanceFunded(chain, address, amount, denom)
+		}
+	}
+}
+
+// assertBankBalanceFunded polls address's bank balance until it holds exactly
+// amount of denom, confirming the faucet funded the requested amount rather
+// than just making address's balance list non-empty.
+func (s *TestSuite) assertBankBalanceFunded(chain *setup.Chain, address, amount, denom string) {
+	if chain.Ports.Rest == 0 {
+		return
+	}
+
+	url := fmt.Sprintf("http://0.0.0.0:%d/cosmos/bank/v1beta1/balances/%s", chain.Ports.Rest, address)
+
+	s.Require().Eventually(func() bool {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return false
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil || resp.StatusCode != 200 {
+			return false
+		}
+		defer resp.Body.Close()
+
+		data := map[string]interface{}{}
+		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+			return false
+		}
+
+		balances, ok := data["balances"].([]interface{})
+		if !ok {
+			return false
+		}
+		for _, b := range balances {
+			bm, ok := b.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if bm["denom"] == denom && bm["amount"] == amount {
+				return true
+			}
+		}
+		return false
+	}, 60*time.Second, 2*time.Second, "faucet funds of %s%s for %s never landed on chain %s", amount, denom, address, chain.ID)
+}
+
+// assertEthBalanceFunded polls address's eth_getBalance until it equals
+// exactly amountWei, confirming the faucet funded the requested amount rather
+// than just making the balance non-zero.
+func (s *TestSuite) assertEthBalanceFunded(chain *setup.Chain, address, amountWei string) {
+	expected, ok := new(big.Int).SetString(amountWei, 10)
+	s.Require().True(ok, "parsing expected amount %q", amountWei)
+	expectedHex := fmt.Sprintf("0x%x", expected)
+
+	url := fmt.Sprintf("http://0.0.0.0:%d", chain.Ports.Rest)
+
+	s.Require().Eventually(func() bool {
+		requestBody, err := json.Marshal(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"method":  "eth_getBalance",
+			"params":  []interface{}{address, "latest"},
+			"id":      1,
+		})
+		if err != nil {
+			return false
+		}
+
+		req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(requestBody))
+		if err != nil {
+			return false
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil || resp.StatusCode != 200 {
+			return false
+		}
+		defer resp.Body.Close()
+
+		data := map[string]interface{}{}
+		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+			return false
+		}
+
+		result, ok := data["result"].(string)
+		return ok && result == expectedHex
+	}, 60*time.Second, 2*time.Second, "faucet funds of %s wei for %s never landed on chain %s", amountWei, address, chain.ID)
+}
@@ -0,0 +1,106 @@
+// Package ibc drives IBC channel creation and transfers through a relayer
+// pod's CLI (eg Hermes) for the e2e test suite.
+package ibc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+)
+
+// Relayer execs into a relayer's pod to drive channel creation and transfers.
+type Relayer struct {
+	Namespace string
+	Pod       string
+	// KubectlBin is the kubectl binary to exec through, defaults to "kubectl".
+	KubectlBin string
+}
+
+// NewRelayer returns a Relayer targeting the relayer pod named pod in namespace.
+func NewRelayer(namespace, pod string) *Relayer {
+	return &Relayer{Namespace: namespace, Pod: pod, KubectlBin: "kubectl"}
+}
+
+func (r *Relayer) exec(args ...string) (string, error) {
+	kubectlBin := r.KubectlBin
+	if kubectlBin == "" {
+		kubectlBin = "kubectl"
+	}
+
+	cmdArgs := append([]string{"exec", r.Pod, "-n", r.Namespace, "--"}, args...)
+	cmd := exec.Command(kubectlBin, cmdArgs...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("kubectl exec %s %v: %w: %s", r.Pod, args, err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// ChannelExists returns whether srcChain lists a channel on port whose
+// counterparty is dstChain and, if so, that channel's id on srcChain. The
+// channel's state is not checked.
+func (r *Relayer) ChannelExists(srcChain, dstChain, port string) (bool, string, error) {
+	out, err := r.exec("hermes", "query", "channels", "--chain", srcChain)
+	if err != nil {
+		return false, "", err
+	}
+
+	var channels []struct {
+		ChannelID    string `json:"channel_id"`
+		PortID       string `json:"port_id"`
+		Counterparty struct {
+			ChainID string `json:"chain_id"`
+		} `json:"counterparty"`
+	}
+	if err := json.Unmarshal([]byte(out), &channels); err != nil {
+		// Hermes' plain-text output can't always be parsed as JSON; treat
+		// that as "unknown" rather than failing the whole query.
+		return false, "", nil
+	}
+
+	for _, c := range channels {
+		if c.PortID == port && c.Counterparty.ChainID == dstChain {
+			return true, c.ChannelID, nil
+		}
+	}
+	return false, "", nil
+}
+
+// CreateChannel creates a new channel between srcChain and dstChain on port,
+// using version and ordering, via `hermes create channel`.
+func (r *Relayer) CreateChannel(srcChain, dstChain, port, version, ordering string) error {
+	args := []string{
+		"hermes", "create", "channel",
+		"--a-chain", srcChain,
+		"--b-chain", dstChain,
+		"--a-port", port,
+		"--b-port", port,
+		"--channel-version", version,
+		"--order", ordering,
+	}
+
+	_, err := r.exec(args...)
+	return err
+}
+
+// Transfer submits an ICS-20 transfer from srcChain to dstChain for amount
+// (eg "100denom") to receiver, using the relayer's `hermes tx ft-transfer`.
+func (r *Relayer) Transfer(srcChain, dstChain, channel, amount, receiver string) error {
+	args := []string{
+		"hermes", "tx", "ft-transfer",
+		"--dst-chain", dstChain,
+		"--src-chain", srcChain,
+		"--src-channel", channel,
+		"--amount", amount,
+	}
+	if receiver != "" {
+		args = append(args, "--receiver", receiver)
+	}
+
+	_, err := r.exec(args...)
+	return err
+}
@@ -0,0 +1,191 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hyperweb-io/starship/tests/e2e/ibc"
+	"github.com/hyperweb-io/starship/tests/e2e/setup"
+)
+
+// TestRelayers_IBCTransfer drives each relayer's configured Channels and
+// Transfers: it makes sure the channel exists (creating it if necessary),
+// submits a transfer, waits for the voucher to land on the destination
+// chain, then reverses the transfer to assert the round trip.
+func (s *TestSuite) TestRelayers_IBCTransfer() {
+	s.T().Log("running IBC channel/transfer matrix for each relayer")
+
+	for _, relayer := range s.config.Relayers {
+		if len(relayer.Channels) == 0 && len(relayer.Transfers) == 0 {
+			continue
+		}
+
+		namespace := relayerNamespace(relayer)
+		s.Require().NotEmpty(namespace, "relayer %s has no configured chains to determine its namespace", relayer.Name)
+
+		pod := fmt.Sprintf("%s-0", relayer.Name)
+		client := ibc.NewRelayer(namespace, pod)
+
+		for i := range relayer.Channels {
+			channel := &relayer.Channels[i]
+
+			exists, channelID, err := client.ChannelExists(channel.SrcChain, channel.DstChain, channel.Port)
+			s.Require().NoError(err, "checking channel %s/%s->%s", channel.Port, channel.SrcChain, channel.DstChain)
+
+			if exists && !channel.Override {
+				channel.ChannelID = channelID
+				continue
+			}
+
+			err = client.CreateChannel(channel.SrcChain, channel.DstChain, channel.Port, channel.Version, channel.Ordering)
+			s.Require().NoError(err, "creating channel %s/%s->%s", channel.Port, channel.SrcChain, channel.DstChain)
+
+			exists, channelID, err = client.ChannelExists(channel.SrcChain, channel.DstChain, channel.Port)
+			s.Require().NoError(err, "confirming created channel %s/%s->%s", channel.Port, channel.SrcChain, channel.DstChain)
+			s.Require().True(exists, "channel %s/%s->%s not found after creation", channel.Port, channel.SrcChain, channel.DstChain)
+			channel.ChannelID = channelID
+		}
+
+		for _, transfer := range relayer.Transfers {
+			srcChain := s.config.GetChain(transfer.SrcChain)
+			dstChain := s.config.GetChain(transfer.DstChain)
+			s.Require().NotNil(srcChain, "src chain %s not found in config", transfer.SrcChain)
+			s.Require().NotNil(dstChain, "dst chain %s not found in config", transfer.DstChain)
+
+			channel := channelBetween(relayer, transfer.SrcChain, transfer.DstChain)
+			s.Require().NotNil(channel, "no channel configured between %s and %s", transfer.SrcChain, transfer.DstChain)
+			s.Require().NotEmpty(channel.ChannelID, "channel between %s and %s has no resolved channel id", transfer.SrcChain, transfer.DstChain)
+
+			amount := fmt.Sprintf("%s%s", transfer.Amount, transfer.Denom)
+			err := client.Transfer(transfer.SrcChain, transfer.DstChain, channel.ChannelID, amount, transfer.Receiver)
+			s.Require().NoError(err, "submitting transfer %s->%s", transfer.SrcChain, transfer.DstChain)
+
+			voucherDenom := s.waitForIBCVoucher(dstChain, transfer.Receiver, transfer.Amount)
+
+			// reverse the transfer to assert the round trip lands back on
+			// the source chain's original denom.
+			reverseAmount := fmt.Sprintf("%s%s", transfer.Amount, voucherDenom)
+			err = client.Transfer(transfer.DstChain, transfer.SrcChain, channel.ChannelID, reverseAmount, transfer.Receiver)
+			s.Require().NoError(err, "reversing transfer %s->%s", transfer.DstChain, transfer.SrcChain)
+
+			s.waitForDenomBalance(srcChain, transfer.Receiver, transfer.Amount, transfer.Denom)
+		}
+	}
+}
+
+// relayerNamespace returns the Kubernetes namespace hosting relayer's pod.
+// Namespaces in this cluster are named after chain ids (see
+// chaos.NewController and execValidator), so a relayer's namespace is the
+// first chain it connects.
+func relayerNamespace(relayer *setup.Relayer) string {
+	if len(relayer.Chains) == 0 {
+		return ""
+	}
+	return relayer.Chains[0]
+}
+
+// channelBetween returns the ChannelSpec configured on relayer between src
+// and dst, or nil if none is configured.
+func channelBetween(relayer *setup.Relayer, src, dst string) *setup.ChannelSpec {
+	for i := range relayer.Channels {
+		if relayer.Channels[i].SrcChain == src && relayer.Channels[i].DstChain == dst {
+			return &relayer.Channels[i]
+		}
+	}
+	return nil
+}
+
+// waitForIBCVoucher polls the destination chain's bank balance until an IBC
+// voucher for the expected amount appears for receiver, and returns the
+// voucher's denom.
+func (s *TestSuite) waitForIBCVoucher(chain *setup.Chain, receiver, expAmount string) string {
+	if chain.Ports.Rest == 0 {
+		return ""
+	}
+
+	url := fmt.Sprintf("http://0.0.0.0:%d/cosmos/bank/v1beta1/balances/%s", chain.Ports.Rest, receiver)
+
+	var voucherDenom string
+	s.Require().Eventually(func() bool {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return false
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil || resp.StatusCode != 200 {
+			return false
+		}
+		defer resp.Body.Close()
+
+		data := map[string]interface{}{}
+		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+			return false
+		}
+
+		balances, ok := data["balances"].([]interface{})
+		if !ok {
+			return false
+		}
+		for _, b := range balances {
+			bm, ok := b.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			denom, _ := bm["denom"].(string)
+			amount, _ := bm["amount"].(string)
+			if amount == expAmount && strings.HasPrefix(denom, "ibc/") {
+				voucherDenom = denom
+				return true
+			}
+		}
+		return false
+	}, 60*time.Second, 2*time.Second, "IBC voucher for %s never landed on destination chain", receiver)
+
+	return voucherDenom
+}
+
+// waitForDenomBalance polls chain's bank balance until receiver holds exactly
+// amount of denom, used to confirm a reversed IBC transfer actually landed
+// back on the source chain rather than just checking the relayer CLI exited 0.
+func (s *TestSuite) waitForDenomBalance(chain *setup.Chain, receiver, amount, denom string) {
+	if chain.Ports.Rest == 0 {
+		return
+	}
+
+	url := fmt.Sprintf("http://0.0.0.0:%d/cosmos/bank/v1beta1/balances/%s", chain.Ports.Rest, receiver)
+
+	s.Require().Eventually(func() bool {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return false
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil || resp.StatusCode != 200 {
+			return false
+		}
+		defer resp.Body.Close()
+
+		data := map[string]interface{}{}
+		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+			return false
+		}
+
+		balances, ok := data["balances"].([]interface{})
+		if !ok {
+			return false
+		}
+		for _, b := range balances {
+			bm, ok := b.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if bm["denom"] == denom && bm["amount"] == amount {
+				return true
+			}
+		}
+		return false
+	}, 60*time.Second, 2*time.Second, "reversed transfer of %s%s never landed back on %s for %s", amount, denom, chain.ID, receiver)
+}
@@ -0,0 +1,177 @@
+// Package setup exposes Starship's e2e config types and a SetupChains entry
+// point so other Go projects depending on github.com/hyperweb-io/starship can
+// spin up Starship-backed test environments without vendoring the e2e test
+// package itself.
+package setup
+
+type Chain struct {
+	ID            string                 `name:"id" json:"id" yaml:"id"`
+	Name          string                 `name:"name" json:"name" yaml:"name"`
+	NumValidators int                    `name:"num-validators" json:"num_validators" yaml:"numValidators"`
+	Cometmock     *Feature               `name:"cometmock" json:"cometmock" yaml:"cometmock"`
+	Faucet        *Feature               `name:"faucet" json:"faucet" yaml:"faucet"`
+	Ports         Port                   `name:"ports" json:"ports" yaml:"ports"`
+	Genesis       map[string]interface{} `name:"genesis" json:"genesis" yaml:"genesis"`
+	Balances      []Balance              `name:"balances" json:"balances" yaml:"balances"`
+	Chaos         *Chaos                 `name:"chaos" json:"chaos" yaml:"chaos"`
+	EthContracts  []EthContract          `name:"eth-contracts" json:"eth_contracts" yaml:"ethContracts"`
+}
+
+// EthContract declares a Solidity contract to deploy against an `ethereum`
+// chain and, optionally, calls to make against it once deployed so the test
+// suite can assert on return values.
+type EthContract struct {
+	Name string `name:"name" json:"name" yaml:"name"`
+	// ArtifactPath is a path to a compiled Hardhat/Foundry artifact JSON
+	// containing "abi" and "bytecode". Mutually exclusive with ABI/Bytecode.
+	ArtifactPath string `name:"artifact-path" json:"artifact_path" yaml:"artifactPath"`
+	// ABI and Bytecode can be set inline instead of ArtifactPath.
+	ABI      string    `name:"abi" json:"abi" yaml:"abi"`
+	Bytecode string    `name:"bytecode" json:"bytecode" yaml:"bytecode"`
+	Args     []string  `name:"args" json:"args" yaml:"args"`
+	Calls    []EthCall `name:"calls" json:"calls" yaml:"calls"`
+}
+
+// EthCall is a read-only contract call made after deployment, with the
+// expected return value asserted against Expected.
+type EthCall struct {
+	Method   string   `name:"method" json:"method" yaml:"method"`
+	Args     []string `name:"args" json:"args" yaml:"args"`
+	Expected string   `name:"expected" json:"expected" yaml:"expected"`
+}
+
+// Chaos declares network and process level faults to inject against a chain's
+// validators (and any relayers connected to it) during an e2e run.
+type Chaos struct {
+	// NetworkFaults are loss/latency (tc netem) or partition (iptables) faults
+	// applied to validator and relayer pods.
+	NetworkFaults []NetworkFault `name:"network-faults" json:"network_faults" yaml:"networkFaults"`
+	// ClockSkews offset a validator's wall clock for the given duration.
+	ClockSkews []ClockSkew `name:"clock-skews" json:"clock_skews" yaml:"clockSkews"`
+	// ValidatorKills pause (SIGSTOP) a validator process and resume (SIGCONT)
+	// it after Restart elapses.
+	ValidatorKills []ValidatorKill `name:"validator-kills" json:"validator_kills" yaml:"validatorKills"`
+}
+
+// NetworkFault describes a loss/latency (tc netem) or partition (iptables)
+// fault applied to one or more pods.
+type NetworkFault struct {
+	// Validators is the list of pod names to target, eg "validator-0". Empty
+	// means every validator for the chain plus any relayer connected to it.
+	Validators []string `name:"validators" json:"validators" yaml:"validators"`
+	Loss       string   `name:"loss" json:"loss" yaml:"loss"`
+	Latency    string   `name:"latency" json:"latency" yaml:"latency"`
+	// Partition, when set, cuts every targeted pod off from each of the other
+	// targeted pods instead of applying loss/latency. TestChains_ChaosRecovery
+	// heals all of a chain's network faults together after a fixed 5s and
+	// does not read Duration.
+	Partition bool   `name:"partition" json:"partition" yaml:"partition"`
+	Duration  string `name:"duration" json:"duration" yaml:"duration"`
+}
+
+// ClockSkew offsets a validator's clock by Offset for Duration.
+type ClockSkew struct {
+	Validator string `name:"validator" json:"validator" yaml:"validator"`
+	Offset    string `name:"offset" json:"offset" yaml:"offset"`
+	Duration  string `name:"duration" json:"duration" yaml:"duration"`
+}
+
+// ValidatorKill pauses a validator process and schedules its restart.
+type ValidatorKill struct {
+	Validator string `name:"validator" json:"validator" yaml:"validator"`
+	Restart   string `name:"restart" json:"restart" yaml:"restart"`
+}
+
+type Port struct {
+	Rest    int `name:"rest" json:"rest" yaml:"rest"`
+	Rpc     int `name:"rpc" json:"rpc" yaml:"rpc"`
+	Grpc    int `name:"grpc" json:"grpc" yaml:"grpc"`
+	Exposer int `name:"exposer" json:"exposer" yaml:"exposer"`
+	Faucet  int `name:"faucet" json:"faucet" yaml:"faucet"`
+}
+
+type Balance struct {
+	Address string `name:"address" json:"address" yaml:"address"`
+	Amount  string `name:"amount" json:"amount" yaml:"amount"`
+}
+
+type Relayer struct {
+	Name      string         `name:"name" json:"name" yaml:"name"`
+	Type      string         `name:"type" json:"type" yaml:"type"`
+	Replicas  int            `name:"replicas" json:"replicas" yaml:"replicas"`
+	Chains    []string       `name:"chains" json:"chains" yaml:"chains"`
+	Ports     Port           `name:"ports" json:"ports" yaml:"ports"`
+	Channels  []ChannelSpec  `name:"channels" json:"channels" yaml:"channels"`
+	Transfers []TransferSpec `name:"transfers" json:"transfers" yaml:"transfers"`
+}
+
+// ChannelSpec declares an IBC channel the relayer should have open (or create
+// if missing) between two chains.
+type ChannelSpec struct {
+	SrcChain string `name:"src-chain" json:"src_chain" yaml:"srcChain"`
+	DstChain string `name:"dst-chain" json:"dst_chain" yaml:"dstChain"`
+	Port     string `name:"port" json:"port" yaml:"port"`
+	Version  string `name:"version" json:"version" yaml:"version"`
+	Ordering string `name:"ordering" json:"ordering" yaml:"ordering"`
+	// Override forces the relayer to recreate the channel even if one
+	// already exists between SrcChain and DstChain on Port.
+	Override bool `name:"override" json:"override" yaml:"override"`
+	// ChannelID is the discovered (or explicitly pinned) channel id to use
+	// for Transfers between SrcChain and DstChain. It is filled in by the
+	// test suite after confirming the channel exists/was created, but can
+	// also be set directly in config to pin a specific channel.
+	ChannelID string `name:"channel-id" json:"channel_id" yaml:"channelId"`
+}
+
+// TransferSpec declares an IBC transfer to submit and verify round-trips back
+// to the sender.
+type TransferSpec struct {
+	SrcChain string `name:"src-chain" json:"src_chain" yaml:"srcChain"`
+	DstChain string `name:"dst-chain" json:"dst_chain" yaml:"dstChain"`
+	Amount   string `name:"amount" json:"amount" yaml:"amount"`
+	Denom    string `name:"denom" json:"denom" yaml:"denom"`
+	Receiver string `name:"receiver" json:"receiver" yaml:"receiver"`
+}
+
+type Feature struct {
+	Enabled  bool   `name:"enabled" json:"enabled" yaml:"enabled"`
+	Image    string `name:"image" json:"image" yaml:"image"`
+	Ports    Port   `name:"ports" json:"ports" yaml:"ports"`
+	Mnemonic string `name:"mnemonic" json:"mnemonic" yaml:"mnemonic"`
+	// NoAuth runs the faucet without any external captcha/social-account
+	// gating, so CI tests can fund arbitrary addresses without an
+	// out-of-band auth step.
+	NoAuth bool `name:"no-auth" json:"no_auth" yaml:"noAuth"`
+}
+
+// Config is the struct for the config.yaml setup file
+// todo: can be moved to proto definition
+type Config struct {
+	Chains   []*Chain   `name:"chains" json:"chains" yaml:"chains"`
+	Relayers []*Relayer `name:"relayers" json:"relayers" yaml:"relayers"`
+	Explorer *Feature   `name:"explorer" json:"explorer" yaml:"explorer"`
+	Registry *Feature   `name:"registry" json:"registry" yaml:"registry"`
+	Faucet   *Feature   `name:"faucet" json:"faucet" yaml:"faucet"`
+}
+
+// HasChainId returns true if chain id found in list of chains
+func (c *Config) HasChainId(chainId string) bool {
+	for _, chain := range c.Chains {
+		if chain.ID == chainId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetChain returns the Chain object pointer for the given chain id
+func (c *Config) GetChain(chainId string) *Chain {
+	for _, chain := range c.Chains {
+		if chain.ID == chainId {
+			return chain
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+package setup
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	hdwallet "github.com/miguelmota/go-ethereum-hdwallet"
+)
+
+// EthSigner signs and submits raw transactions against an `ethereum` chain's
+// JSON-RPC endpoint, using a key derived from the faucet mnemonic.
+type EthSigner struct {
+	wallet  *hdwallet.Wallet
+	account accounts.Account
+	chainID *big.Int
+}
+
+// NewEthSignerFromMnemonic derives the default account (m/44'/60'/0'/0/0) from
+// mnemonic and returns a signer for the given chain id.
+func NewEthSignerFromMnemonic(mnemonic string, chainID *big.Int) (*EthSigner, error) {
+	wallet, err := hdwallet.NewFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("deriving wallet from mnemonic: %w", err)
+	}
+
+	path := hdwallet.MustParseDerivationPath("m/44'/60'/0'/0/0")
+	account, err := wallet.Derive(path, false)
+	if err != nil {
+		return nil, fmt.Errorf("deriving account: %w", err)
+	}
+
+	return &EthSigner{wallet: wallet, account: account, chainID: chainID}, nil
+}
+
+// Address returns the signer's Ethereum address.
+func (s *EthSigner) Address() common.Address {
+	return s.account.Address
+}
+
+// ethRPC posts a JSON-RPC request to url and decodes the "result" field into out.
+func ethRPC(url, method string, params []interface{}, out interface{}) error {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+		"id":      1,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var data struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return err
+	}
+	if data.Error != nil {
+		return fmt.Errorf("%s: %s", method, data.Error.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data.Result, out)
+}
+
+// estimateGas calls eth_estimateGas for a transaction sending data to to
+// (nil for contract creation).
+func estimateGas(url string, from common.Address, to *common.Address, data []byte) (uint64, error) {
+	tx := map[string]interface{}{
+		"from": from.Hex(),
+		"data": "0x" + hex.EncodeToString(data),
+	}
+	if to != nil {
+		tx["to"] = to.Hex()
+	}
+
+	var result string
+	if err := ethRPC(url, "eth_estimateGas", []interface{}{tx}, &result); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimPrefix(result, "0x"), 16, 64)
+}
+
+// nonceAt returns the pending transaction count for addr, used as the next nonce.
+func nonceAt(url string, addr common.Address) (uint64, error) {
+	var result string
+	if err := ethRPC(url, "eth_getTransactionCount", []interface{}{addr.Hex(), "pending"}, &result); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimPrefix(result, "0x"), 16, 64)
+}
+
+// gasPrice returns the node's suggested gas price.
+func gasPrice(url string) (*big.Int, error) {
+	var result string
+	if err := ethRPC(url, "eth_gasPrice", nil, &result); err != nil {
+		return nil, err
+	}
+	price, ok := new(big.Int).SetString(strings.TrimPrefix(result, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("parsing gas price %q", result)
+	}
+	return price, nil
+}
+
+// sendRawTransaction signs a transaction sending data to `to` (nil deploys a
+// contract) and submits it via eth_sendRawTransaction, returning the tx hash.
+func (s *EthSigner) sendRawTransaction(url string, to *common.Address, data []byte, value *big.Int) (common.Hash, error) {
+	nonce, err := nonceAt(url, s.Address())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("fetching nonce: %w", err)
+	}
+
+	gas, err := estimateGas(url, s.Address(), to, data)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("estimating gas: %w", err)
+	}
+
+	price, err := gasPrice(url)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("fetching gas price: %w", err)
+	}
+
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    value,
+		Gas:      gas,
+		GasPrice: price,
+		Data:     data,
+	})
+
+	signedTx, err := s.wallet.SignTx(s.account, tx, s.chainID)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("signing tx: %w", err)
+	}
+
+	rawTx, err := signedTx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var txHash string
+	if err := ethRPC(url, "eth_sendRawTransaction", []interface{}{"0x" + hex.EncodeToString(rawTx)}, &txHash); err != nil {
+		return common.Hash{}, fmt.Errorf("sending raw tx: %w", err)
+	}
+
+	return common.HexToHash(txHash), nil
+}
+
+// waitForReceipt polls eth_getTransactionReceipt until the receipt is mined
+// or ctx is done.
+func waitForReceipt(ctx context.Context, url string, txHash common.Hash) (map[string]interface{}, error) {
+	for {
+		var receipt map[string]interface{}
+		if err := ethRPC(url, "eth_getTransactionReceipt", []interface{}{txHash.Hex()}, &receipt); err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for receipt of %s: %w", txHash.Hex(), ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// DeployContract deploys the contract described by cfg and returns its
+// on-chain address once the deployment receipt is mined.
+func DeployContract(ctx context.Context, url string, signer *EthSigner, cfg EthContract) (common.Address, error) {
+	parsedABI, bytecode, err := LoadArtifact(cfg)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	args, err := convertArgs(parsedABI.Constructor.Inputs, cfg.Args)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("converting constructor args: %w", err)
+	}
+
+	packedArgs, err := parsedABI.Pack("", args...)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("packing constructor args: %w", err)
+	}
+	// Pack("", ...) only returns the argument encoding, so prepend the bytecode.
+	data := append(bytecode, packedArgs...)
+
+	txHash, err := signer.sendRawTransaction(url, nil, data, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	receipt, err := waitForReceipt(ctx, url, txHash)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	addr, ok := receipt["contractAddress"].(string)
+	if !ok || addr == "" {
+		return common.Address{}, fmt.Errorf("deployment receipt missing contractAddress: %v", receipt)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+// CallContract makes a read-only eth_call against contract and ABI-decodes
+// the return values.
+func CallContract(url string, contract common.Address, parsedABI abi.ABI, call EthCall) ([]interface{}, error) {
+	method, ok := parsedABI.Methods[call.Method]
+	if !ok {
+		return nil, fmt.Errorf("method %q not found in ABI", call.Method)
+	}
+
+	args, err := convertArgs(method.Inputs, call.Args)
+	if err != nil {
+		return nil, fmt.Errorf("converting call args: %w", err)
+	}
+
+	data, err := parsedABI.Pack(call.Method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("packing call args: %w", err)
+	}
+
+	tx := map[string]interface{}{
+		"to":   contract.Hex(),
+		"data": "0x" + hex.EncodeToString(data),
+	}
+
+	var result string
+	if err := ethRPC(url, "eth_call", []interface{}{tx, "latest"}, &result); err != nil {
+		return nil, err
+	}
+
+	raw, err := hex.DecodeString(strings.TrimPrefix(result, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedABI.Unpack(call.Method, raw)
+}
+
+// LoadArtifact resolves cfg's ABI/bytecode either from an inline ABI+Bytecode
+// pair or from a compiled Hardhat/Foundry artifact JSON file.
+func LoadArtifact(cfg EthContract) (abi.ABI, []byte, error) {
+	abiJSON := cfg.ABI
+	bytecodeHex := cfg.Bytecode
+
+	if cfg.ArtifactPath != "" {
+		raw, err := os.ReadFile(cfg.ArtifactPath)
+		if err != nil {
+			return abi.ABI{}, nil, fmt.Errorf("reading artifact %s: %w", cfg.ArtifactPath, err)
+		}
+
+		var artifact struct {
+			ABI      json.RawMessage `json:"abi"`
+			Bytecode string          `json:"bytecode"`
+		}
+		if err := json.Unmarshal(raw, &artifact); err != nil {
+			return abi.ABI{}, nil, fmt.Errorf("parsing artifact %s: %w", cfg.ArtifactPath, err)
+		}
+		abiJSON = string(artifact.ABI)
+		bytecodeHex = artifact.Bytecode
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return abi.ABI{}, nil, fmt.Errorf("parsing ABI for %s: %w", cfg.Name, err)
+	}
+
+	bytecode, err := hex.DecodeString(strings.TrimPrefix(bytecodeHex, "0x"))
+	if err != nil {
+		return abi.ABI{}, nil, fmt.Errorf("decoding bytecode for %s: %w", cfg.Name, err)
+	}
+
+	return parsedABI, bytecode, nil
+}
+
+// convertArgs converts the string-typed args declared in YAML into the Go
+// types abi.Pack expects, based on the ABI argument definitions.
+func convertArgs(inputs abi.Arguments, raw []string) ([]interface{}, error) {
+	if len(raw) != len(inputs) {
+		return nil, fmt.Errorf("expected %d args, got %d", len(inputs), len(raw))
+	}
+
+	args := make([]interface{}, len(raw))
+	for i, in := range inputs {
+		switch {
+		case in.Type.T == abi.UintTy || in.Type.T == abi.IntTy:
+			v, ok := new(big.Int).SetString(raw[i], 10)
+			if !ok {
+				return nil, fmt.Errorf("arg %d: %q is not an integer", i, raw[i])
+			}
+			args[i] = packInt(in.Type, v)
+		case in.Type.String() == "address":
+			args[i] = common.HexToAddress(raw[i])
+		case in.Type.String() == "bool":
+			args[i] = raw[i] == "true"
+		default:
+			args[i] = raw[i]
+		}
+	}
+	return args, nil
+}
+
+// packInt returns v as the Go type abi.Pack expects for t: the matching
+// native int/uint for 8, 16, 32 and 64 bit widths, and *big.Int itself for
+// every other width (abi.Pack rejects a bare int64/uint64 for those).
+func packInt(t abi.Type, v *big.Int) interface{} {
+	if t.T == abi.UintTy {
+		switch t.Size {
+		case 8:
+			return uint8(v.Uint64())
+		case 16:
+			return uint16(v.Uint64())
+		case 32:
+			return uint32(v.Uint64())
+		case 64:
+			return v.Uint64()
+		default:
+			return v
+		}
+	}
+
+	switch t.Size {
+	case 8:
+		return int8(v.Int64())
+	case 16:
+		return int16(v.Int64())
+	case 32:
+		return int32(v.Int64())
+	case 64:
+		return v.Int64()
+	default:
+		return v
+	}
+}
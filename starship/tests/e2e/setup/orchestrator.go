@@ -0,0 +1,168 @@
+package setup
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// Orchestrator manages the lifecycle of a Starship-backed test environment:
+// installing the Helm chart for a config, port-forwarding its chains and
+// relayers to localhost, and tearing everything down again.
+type Orchestrator struct {
+	t           *testing.T
+	Namespace   string
+	ReleaseName string
+	Config      *Config
+
+	forwards []*exec.Cmd
+}
+
+// SetupChains installs the Starship Helm chart for the config at configPath
+// into its own namespace, port-forwards the chain and relayer ports listed on
+// portForward, and registers a t.Cleanup to tear both down. It returns once
+// the port-forward processes have started; it does not wait for the ports to
+// accept connections.
+func SetupChains(t *testing.T, configPath string) *Orchestrator {
+	t.Helper()
+
+	yamlFile, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("reading config %s: %v", configPath, err)
+	}
+
+	config := &Config{}
+	if err := yaml.Unmarshal(yamlFile, config); err != nil {
+		t.Fatalf("parsing config %s: %v", configPath, err)
+	}
+
+	o := &Orchestrator{
+		t:           t,
+		Namespace:   releaseNameFor(configPath),
+		ReleaseName: releaseNameFor(configPath),
+		Config:      config,
+	}
+
+	t.Cleanup(func() {
+		if err := o.Teardown(); err != nil {
+			t.Logf("tearing down orchestrator: %v", err)
+		}
+	})
+
+	if err := o.install(configPath); err != nil {
+		t.Fatalf("installing starship chart: %v", err)
+	}
+	if err := o.portForward(); err != nil {
+		t.Fatalf("port-forwarding starship pods: %v", err)
+	}
+
+	return o
+}
+
+// MakeRequest performs req and requires it to return expCode, returning the
+// response body for callers to decode. It lives here rather than on the e2e
+// suite so any test package driving a Starship-backed environment can reuse
+// it without depending on the e2e test package itself.
+func MakeRequest(t *testing.T, req *http.Request, expCode int) io.Reader {
+	t.Helper()
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "trying to make request")
+	require.Equal(t, expCode, resp.StatusCode, "response code did not match")
+
+	return resp.Body
+}
+
+// releaseNameFor derives a stable helm release/namespace name from a config
+// file path, eg "configs/two-chain.yaml" -> "starship-two-chain".
+func releaseNameFor(configPath string) string {
+	base := configPath[strings.LastIndex(configPath, "/")+1:]
+	base = strings.TrimSuffix(base, ".yaml")
+	base = strings.TrimSuffix(base, ".yml")
+	return fmt.Sprintf("starship-%s", base)
+}
+
+func (o *Orchestrator) install(configPath string) error {
+	cmd := exec.Command("helm", "install", o.ReleaseName, "starship/devnet",
+		"-f", configPath,
+		"-n", o.Namespace,
+		"--create-namespace",
+		"--wait",
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("helm install: %w: %s", err, stderr.String())
+	}
+	return nil
+}
+
+// portForward opens `kubectl port-forward` for each chain's rpc, rest, grpc
+// and faucet ports and each relayer's rest port, so tests can hit
+// `http://0.0.0.0:<port>` directly, matching how the e2e tests already
+// address chains. Exposer ports and the ports of features (cometmock,
+// faucet, explorer, registry) are not forwarded.
+func (o *Orchestrator) portForward() error {
+	forward := func(pod string, port int) error {
+		if port == 0 {
+			return nil
+		}
+		cmd := exec.Command("kubectl", "port-forward", pod, fmt.Sprintf("%d:%d", port, port), "-n", o.Namespace)
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("port-forward %s:%d: %w", pod, port, err)
+		}
+		o.forwards = append(o.forwards, cmd)
+		return nil
+	}
+
+	for _, chain := range o.Config.Chains {
+		pod := fmt.Sprintf("%s-genesis-0", chain.ID)
+		if err := forward(pod, chain.Ports.Rpc); err != nil {
+			return err
+		}
+		if err := forward(pod, chain.Ports.Rest); err != nil {
+			return err
+		}
+		if err := forward(pod, chain.Ports.Grpc); err != nil {
+			return err
+		}
+		if err := forward(pod, chain.Ports.Faucet); err != nil {
+			return err
+		}
+	}
+
+	for _, relayer := range o.Config.Relayers {
+		pod := fmt.Sprintf("%s-0", relayer.Name)
+		if err := forward(pod, relayer.Ports.Rest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Teardown stops all port-forwards and uninstalls the Helm release.
+func (o *Orchestrator) Teardown() error {
+	for _, cmd := range o.forwards {
+		if cmd.Process != nil {
+			_ = cmd.Process.Kill()
+		}
+	}
+	o.forwards = nil
+
+	cmd := exec.Command("helm", "uninstall", o.ReleaseName, "-n", o.Namespace)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("helm uninstall: %w: %s", err, stderr.String())
+	}
+	return nil
+}
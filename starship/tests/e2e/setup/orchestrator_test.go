@@ -0,0 +1,18 @@
+package setup
+
+import "testing"
+
+func TestReleaseNameFor(t *testing.T) {
+	cases := map[string]string{
+		"configs/two-chain.yaml":     "starship-two-chain",
+		"configs/ibc.yml":            "starship-ibc",
+		"one-chain.yaml":             "starship-one-chain",
+		"tests/e2e/configs/eth.yaml": "starship-eth",
+	}
+
+	for configPath, want := range cases {
+		if got := releaseNameFor(configPath); got != want {
+			t.Errorf("releaseNameFor(%q) = %q, want %q", configPath, got, want)
+		}
+	}
+}